@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestRegistryMockProvider(t *testing.T) {
+	r := NewRegistry()
+	r.Register("mock", NewMockProvider)
+
+	provider, err := r.Get("mock", Config{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	customer, err := provider.NewCustomer(context.Background())
+	if err != nil {
+		t.Fatalf("NewCustomer: %v", err)
+	}
+	if customer.Name != "Mock Customer" {
+		t.Errorf("wrong customer: %+v", customer)
+	}
+
+	seller, err := provider.NewSeller(context.Background())
+	if err != nil {
+		t.Fatalf("NewSeller: %v", err)
+	}
+	if seller.Name != "Mock Seller" {
+		t.Errorf("wrong seller: %+v", seller)
+	}
+}
+
+func TestRegistryUnknownProvider(t *testing.T) {
+	r := NewRegistry()
+
+	_, err := r.Get("unknown", Config{})
+	if err == nil {
+		t.Fatal("expected error for unknown provider")
+	}
+
+	var unknownErr *UnknownProviderError
+	if !errors.As(err, &unknownErr) {
+		t.Fatalf("expected *UnknownProviderError, got %T", err)
+	}
+}
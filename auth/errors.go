@@ -0,0 +1,13 @@
+package auth
+
+import "fmt"
+
+// UnknownProviderError возвращается Registry.Get, если под переданным именем
+// не зарегистрирована ни одна фабрика.
+type UnknownProviderError struct {
+	Name string
+}
+
+func (e *UnknownProviderError) Error() string {
+	return fmt.Sprintf("auth: unknown provider %q", e.Name)
+}
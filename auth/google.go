@@ -0,0 +1,61 @@
+package auth
+
+import (
+	"context"
+	"sync"
+)
+
+// GoogleProvider — Provider, аутентифицирующий пользователей через Google OIDC.
+// Discovery и обмен токеном откладываются до первого NewCustomer/NewSeller,
+// поэтому NewGoogleProvider не может провалиться сама по себе.
+type GoogleProvider struct {
+	cfg Config
+
+	mu   sync.Mutex
+	base *oidcProvider
+}
+
+// NewGoogleProvider возвращает Provider, использующий discovery-документ Google.
+func NewGoogleProvider(cfg Config) Provider {
+	if cfg.IssuerURL == "" {
+		cfg.IssuerURL = "https://accounts.google.com"
+	}
+	return &GoogleProvider{cfg: cfg}
+}
+
+func (g *GoogleProvider) ensure(ctx context.Context) (*oidcProvider, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.base == nil {
+		base, err := newOIDCProvider(ctx, g.cfg)
+		if err != nil {
+			return nil, err
+		}
+		g.base = base
+	}
+	return g.base, nil
+}
+
+func (g *GoogleProvider) NewCustomer(ctx context.Context) (*Customer, error) {
+	base, err := g.ensure(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c, err := base.fetchClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Customer{ID: c.Subject, Name: c.Name, Email: c.Email}, nil
+}
+
+func (g *GoogleProvider) NewSeller(ctx context.Context) (*Seller, error) {
+	base, err := g.ensure(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c, err := base.fetchClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Seller{ID: c.Subject, Name: c.Name, Email: c.Email}, nil
+}
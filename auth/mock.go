@@ -0,0 +1,28 @@
+package auth
+
+import "context"
+
+// MockProvider — Provider с фиксированными данными, для использования в тестах
+// и в демонстрационных запусках без доступа к реальному IdP.
+type MockProvider struct {
+	Customer Customer
+	Seller   Seller
+}
+
+// NewMockProvider возвращает Provider, всегда отдающий одни и те же Customer и Seller.
+func NewMockProvider(cfg Config) Provider {
+	return &MockProvider{
+		Customer: Customer{ID: "mock-customer", Name: "Mock Customer", Email: "customer@mock.test"},
+		Seller:   Seller{ID: "mock-seller", Name: "Mock Seller", Email: "seller@mock.test"},
+	}
+}
+
+func (m *MockProvider) NewCustomer(ctx context.Context) (*Customer, error) {
+	c := m.Customer
+	return &c, nil
+}
+
+func (m *MockProvider) NewSeller(ctx context.Context) (*Seller, error) {
+	s := m.Seller
+	return &s, nil
+}
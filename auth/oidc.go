@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"golang.org/x/oauth2"
+)
+
+// claims — поля userinfo-ответа, общие для провайдеров, реализованных в этом пакете.
+type claims struct {
+	Subject string `json:"sub"`
+	Name    string `json:"name"`
+	Email   string `json:"email"`
+}
+
+// oidcProvider выполняет discovery и authorization-code обмен токеном,
+// общий для GoogleProvider и YandexProvider.
+type oidcProvider struct {
+	userinfoEndpoint string
+	client           *http.Client
+}
+
+// newOIDCProvider выполняет discovery по cfg.IssuerURL и обменивает cfg.Code
+// (код авторизации, полученный после входа пользователя в IdP) на токен
+// доступа этого пользователя. В отличие от client-credentials, этот токен
+// несёт identity конкретного человека, поэтому fetchClaims возвращает его
+// реальные name/email, а не identity самого сервиса.
+func newOIDCProvider(ctx context.Context, cfg Config) (*oidcProvider, error) {
+	if cfg.Code == "" {
+		return nil, fmt.Errorf("auth: Config.Code is required: obtain it by redirecting the user through the IdP login page")
+	}
+
+	doc, err := discover(ctx, cfg.IssuerURL)
+	if err != nil {
+		return nil, err
+	}
+
+	oauthCfg := oauth2.Config{
+		ClientID:     cfg.ClientID,
+		ClientSecret: cfg.ClientSecret,
+		RedirectURL:  cfg.RedirectURL,
+		Scopes:       cfg.Scopes,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  doc.AuthorizationEndpoint,
+			TokenURL: doc.TokenEndpoint,
+		},
+	}
+
+	token, err := oauthCfg.Exchange(ctx, cfg.Code)
+	if err != nil {
+		return nil, fmt.Errorf("auth: exchange authorization code: %w", err)
+	}
+
+	return &oidcProvider{
+		userinfoEndpoint: doc.UserinfoEndpoint,
+		client:           oauthCfg.Client(ctx, token),
+	}, nil
+}
+
+// fetchClaims запрашивает userinfo-эндпоинт и декодирует общие поля профиля.
+func (p *oidcProvider) fetchClaims(ctx context.Context) (*claims, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.userinfoEndpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("auth: build userinfo request: %w", err)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("auth: fetch userinfo: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("auth: userinfo endpoint returned %s", resp.Status)
+	}
+
+	var c claims
+	if err := json.NewDecoder(resp.Body).Decode(&c); err != nil {
+		return nil, fmt.Errorf("auth: decode userinfo: %w", err)
+	}
+	return &c, nil
+}
@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+)
+
+// Customer — покупатель, полученный от внешнего провайдера идентификации.
+type Customer struct {
+	ID    string
+	Name  string
+	Email string
+}
+
+func (c *Customer) String() string {
+	return fmt.Sprintf("Customer: %s <%s>", c.Name, c.Email)
+}
+
+// Seller — продавец, полученный от внешнего провайдера идентификации.
+type Seller struct {
+	ID    string
+	Name  string
+	Email string
+}
+
+func (s *Seller) String() string {
+	return fmt.Sprintf("Seller: %s <%s>", s.Name, s.Email)
+}
+
+// Config — параметры подключения к OIDC-провайдеру.
+type Config struct {
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+
+	// Code — код авторизации, полученный провайдером после того, как
+	// пользователь вошёл в IdP и браузер вернулся на RedirectURL
+	// (authorization-code flow). Без него NewCustomer/NewSeller не могут
+	// получить claims конкретного пользователя.
+	Code string
+}
+
+// Provider — абстрактная фабрика пользователей, аутентифицированных через OIDC.
+// Конкретные реализации получают имя, email и ID либо из настоящего провайдера
+// (см. GoogleProvider, YandexProvider), либо из фиксированных данных (см. MockProvider).
+type Provider interface {
+	NewCustomer(ctx context.Context) (*Customer, error)
+	NewSeller(ctx context.Context) (*Seller, error)
+}
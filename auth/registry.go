@@ -0,0 +1,47 @@
+package auth
+
+import "sync"
+
+// Factory создаёт Provider по конфигурации подключения к нему.
+type Factory func(cfg Config) Provider
+
+// Registry хранит фабрики провайдеров и позволяет регистрировать новые во
+// время выполнения, не трогая код этого пакета — так сторонние IdP (GitHub,
+// Keycloak, Azure AD, ...) подключаются без правки auth.
+type Registry struct {
+	mu        sync.RWMutex
+	factories map[string]Factory
+}
+
+// NewRegistry создаёт пустой реестр фабрик.
+func NewRegistry() *Registry {
+	return &Registry{factories: make(map[string]Factory)}
+}
+
+// Register сохраняет factory под именем name, перезаписывая ранее
+// зарегистрированную под тем же именем.
+func (r *Registry) Register(name string, factory Factory) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.factories[name] = factory
+}
+
+// Get создаёт Provider фабрикой, зарегистрированной под именем name.
+// Возвращает *UnknownProviderError, если такой фабрики нет.
+func (r *Registry) Get(name string, cfg Config) (Provider, error) {
+	r.mu.RLock()
+	factory, ok := r.factories[name]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, &UnknownProviderError{Name: name}
+	}
+	return factory(cfg), nil
+}
+
+// Default — реестр со встроенными провайдерами (google, yandex).
+var Default = NewRegistry()
+
+func init() {
+	Default.Register("google", NewGoogleProvider)
+	Default.Register("yandex", NewYandexProvider)
+}
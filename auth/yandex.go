@@ -0,0 +1,59 @@
+package auth
+
+import (
+	"context"
+	"sync"
+)
+
+// YandexProvider — Provider, аутентифицирующий пользователей через Yandex OIDC.
+type YandexProvider struct {
+	cfg Config
+
+	mu   sync.Mutex
+	base *oidcProvider
+}
+
+// NewYandexProvider возвращает Provider, использующий discovery-документ Yandex.
+func NewYandexProvider(cfg Config) Provider {
+	if cfg.IssuerURL == "" {
+		cfg.IssuerURL = "https://oauth.yandex.ru"
+	}
+	return &YandexProvider{cfg: cfg}
+}
+
+func (y *YandexProvider) ensure(ctx context.Context) (*oidcProvider, error) {
+	y.mu.Lock()
+	defer y.mu.Unlock()
+	if y.base == nil {
+		base, err := newOIDCProvider(ctx, y.cfg)
+		if err != nil {
+			return nil, err
+		}
+		y.base = base
+	}
+	return y.base, nil
+}
+
+func (y *YandexProvider) NewCustomer(ctx context.Context) (*Customer, error) {
+	base, err := y.ensure(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c, err := base.fetchClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Customer{ID: c.Subject, Name: c.Name, Email: c.Email}, nil
+}
+
+func (y *YandexProvider) NewSeller(ctx context.Context) (*Seller, error) {
+	base, err := y.ensure(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c, err := base.fetchClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Seller{ID: c.Subject, Name: c.Name, Email: c.Email}, nil
+}
@@ -0,0 +1,53 @@
+// Package optionbuilder — переиспользуемая реализация паттерна
+// функциональных опций поверх дженериков, без необходимости писать
+// по одному замыканию на каждое поле структуры.
+package optionbuilder
+
+import "fmt"
+
+// Option изменяет поле значения T при его построении.
+type Option[T any] func(*T)
+
+// Build применяет opts к нулевому значению T по порядку и возвращает
+// указатель на результат.
+func Build[T any](opts ...Option[T]) *T {
+	var v T
+	for _, opt := range opts {
+		opt(&v)
+	}
+	return &v
+}
+
+// Validator проверяет значение T после применения всех опций.
+type Validator[T any] func(*T) error
+
+// BuildE ведёт себя как Build, но дополнительно прогоняет построенное
+// значение через validators и возвращает первую встреченную ошибку.
+func BuildE[T any](opts []Option[T], validators ...Validator[T]) (*T, error) {
+	v := Build(opts...)
+	for _, validate := range validators {
+		if err := validate(v); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+// Set возвращает Option, записывающую v в поле, на которое указывает getter.
+func Set[T any, V any](getter func(*T) *V, v V) Option[T] {
+	return func(t *T) {
+		*getter(t) = v
+	}
+}
+
+// Required возвращает Validator, завершающийся ошибкой, если поле,
+// выбранное getter, осталось равным нулевому значению V.
+func Required[T any, V comparable](name string, getter func(*T) *V) Validator[T] {
+	var zero V
+	return func(t *T) error {
+		if *getter(t) == zero {
+			return fmt.Errorf("optionbuilder: required field %q was not set", name)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,39 @@
+package optionbuilder
+
+import "testing"
+
+type testConfig struct {
+	addr string
+	port int
+}
+
+func withAddr(addr string) Option[testConfig] {
+	return Set(func(c *testConfig) *string { return &c.addr }, addr)
+}
+
+func withPort(port int) Option[testConfig] {
+	return Set(func(c *testConfig) *int { return &c.port }, port)
+}
+
+func TestBuild(t *testing.T) {
+	c := Build(withAddr("localhost"), withPort(8080))
+
+	if c.addr != "localhost" || c.port != 8080 {
+		t.Errorf("wrong config: %+v", c)
+	}
+}
+
+func TestBuildE(t *testing.T) {
+	_, err := BuildE([]Option[testConfig]{withPort(8080)}, Required[testConfig, string]("addr", func(c *testConfig) *string { return &c.addr }))
+	if err == nil {
+		t.Fatal("expected error for missing addr")
+	}
+
+	c, err := BuildE([]Option[testConfig]{withAddr("localhost"), withPort(8080)}, Required[testConfig, string]("addr", func(c *testConfig) *string { return &c.addr }))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.addr != "localhost" {
+		t.Errorf("wrong config: %+v", c)
+	}
+}
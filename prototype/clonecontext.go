@@ -0,0 +1,12 @@
+package prototype
+
+// CloneContext отслеживает уже клонированные узлы по их identity, чтобы
+// дерево с общими поддеревьями или циклами клонировалось конечное число раз.
+type CloneContext struct {
+	Seen map[Node]Node
+}
+
+// NewCloneContext создаёт пустой CloneContext для одного обхода Clone.
+func NewCloneContext() *CloneContext {
+	return &CloneContext{Seen: make(map[Node]Node)}
+}
@@ -0,0 +1,109 @@
+package prototype
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+)
+
+// WriteEntryConcurrent сериализует дерево так же, как n.WriteEntry, но
+// вычисляет собственную строку каждого узла в общем пуле из opts.Workers
+// воркеров (не меньше одного) — пул ограничивает число одновременных
+// вычислений для дерева целиком, а не для каждого уровня вложенности по
+// отдельности. Узлы собираются Walk в порядке обхода в плоский список задач,
+// поэтому ни одна задача не дожидается завершения другой: захваченный слот
+// пула освобождается сразу после вычисления строки, без риска дедлока между
+// родителем и потомком. Буферы результатов объединяются в порядке обхода,
+// поэтому вывод побайтово совпадает с последовательным TreeFormatter.
+//
+// JSON и YAML — структурные форматы, которые нельзя собрать конкатенацией
+// строк по отдельным узлам, поэтому для них WriteEntryConcurrent сериализует
+// дерево последовательно через formatterFor(opts.Format).
+func WriteEntryConcurrent(ctx context.Context, n Node, w io.Writer, opts ...WriteOption) error {
+	writeOpts := NewWriteOpts(opts...)
+
+	if writeOpts.Format != FormatTree {
+		return formatterFor(writeOpts.Format).Format(w, n, writeOpts)
+	}
+
+	var jobs []writeJob
+	if err := Walk(ctx, n, func(n Node, opts WriteOpts) error {
+		jobs = append(jobs, writeJob{node: n, opts: opts})
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	bufs, err := runWriteJobs(ctx, jobs, writeOpts.Workers)
+	if err != nil {
+		return err
+	}
+	for _, buf := range bufs {
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeJob — узел дерева вместе с WriteOpts, под которыми вычисляется его
+// собственная строка (без рекурсии в детей — за неё отвечает порядок jobs).
+type writeJob struct {
+	node Node
+	opts WriteOpts
+}
+
+// runWriteJobs вычисляет собственную строку каждого job в пуле из не более
+// чем workers (не меньше одного) одновременных горутин и возвращает буферы
+// в исходном порядке jobs.
+func runWriteJobs(ctx context.Context, jobs []writeJob, workers int) ([]*bytes.Buffer, error) {
+	if workers < 1 {
+		workers = 1
+	}
+	sem := make(chan struct{}, workers)
+
+	bufs := make([]*bytes.Buffer, len(jobs))
+	errs := make([]error, len(jobs))
+
+	var wg sync.WaitGroup
+	for i, job := range jobs {
+		i, job := i, job
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			bufs[i], errs[i] = writeOwnEntry(ctx, job.node, job.opts)
+		}()
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return bufs, nil
+}
+
+// writeOwnEntry вычисляет строку, которой n.WriteEntry предваряет рекурсию в
+// детей — для Folder это только строка самой директории, без Children.
+func writeOwnEntry(ctx context.Context, n Node, opts WriteOpts) (*bytes.Buffer, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if folder, ok := n.(*Folder); ok {
+		if err := folder.File.WriteEntry(&buf, opts); err != nil {
+			return nil, err
+		}
+		return &buf, nil
+	}
+	if err := n.WriteEntry(&buf, opts); err != nil {
+		return nil, err
+	}
+	return &buf, nil
+}
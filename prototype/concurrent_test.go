@@ -0,0 +1,71 @@
+package prototype
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+func sampleTree() *Folder {
+	return &Folder{
+		File: File{Name: "root"},
+		Children: []Node{
+			&File{Name: "file1"},
+			&File{Name: "file2"},
+			&Folder{
+				File:     File{Name: "subfolder"},
+				Children: []Node{&File{Name: "file3"}, &File{Name: "file4"}},
+			},
+		},
+	}
+}
+
+func TestWalkVisitsEveryNodeInOrder(t *testing.T) {
+	var visited []string
+	err := Walk(context.Background(), sampleTree(), func(n Node, opts WriteOpts) error {
+		switch v := n.(type) {
+		case *Folder:
+			visited = append(visited, v.Name)
+		case *File:
+			visited = append(visited, v.Name)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"root", "file1", "file2", "subfolder", "file3", "file4"}
+	if strings.Join(visited, ",") != strings.Join(want, ",") {
+		t.Errorf("wrong visit order: %v", visited)
+	}
+}
+
+func TestWriteEntryConcurrentMatchesSequentialOutput(t *testing.T) {
+	tree := sampleTree()
+
+	var sequential bytes.Buffer
+	if err := tree.WriteEntry(&sequential, WriteOpts{}); err != nil {
+		t.Fatalf("WriteEntry: %v", err)
+	}
+
+	var concurrent bytes.Buffer
+	if err := WriteEntryConcurrent(context.Background(), tree, &concurrent, WithWorkers(4)); err != nil {
+		t.Fatalf("WriteEntryConcurrent: %v", err)
+	}
+
+	if sequential.String() != concurrent.String() {
+		t.Errorf("concurrent output diverged from sequential:\nsequential:\n%s\nconcurrent:\n%s", sequential.String(), concurrent.String())
+	}
+}
+
+func TestWriteEntryConcurrentJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteEntryConcurrent(context.Background(), sampleTree(), &buf, WithFormat(FormatJSON)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(buf.String(), `"name": "subfolder"`) {
+		t.Errorf("expected JSON output to contain subfolder, got: %s", buf.String())
+	}
+}
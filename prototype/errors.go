@@ -0,0 +1,13 @@
+package prototype
+
+import "fmt"
+
+// UnknownPrototypeError возвращается Registry.Get, если под переданным
+// именем не зарегистрирован ни один прототип.
+type UnknownPrototypeError struct {
+	Name string
+}
+
+func (e *UnknownPrototypeError) Error() string {
+	return fmt.Sprintf("prototype: unknown prototype %q", e.Name)
+}
@@ -0,0 +1,28 @@
+package prototype
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+type File struct {
+	Name string
+}
+
+// WriteEntry выводит имя файла с нужным сдвигом.
+func (f *File) WriteEntry(w io.Writer, opts WriteOpts) error {
+	_, err := fmt.Fprintf(w, "%s%s\n", strings.Repeat("    ", opts.Level), f.Name)
+	return err
+}
+
+// CloneWithCtx возвращает копию файла, регистрируя её в ctx.Seen.
+func (f *File) CloneWithCtx(ctx *CloneContext) Node {
+	if clone, ok := ctx.Seen[f]; ok {
+		return clone
+	}
+
+	clone := &File{Name: f.Name}
+	ctx.Seen[f] = clone
+	return clone
+}
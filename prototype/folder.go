@@ -0,0 +1,60 @@
+package prototype
+
+import (
+	"io"
+	"strings"
+)
+
+type Folder struct {
+	File     // вложенная структура File
+	Children []Node
+}
+
+// WriteEntry выводит имя директории и её содержимое.
+func (f *Folder) WriteEntry(w io.Writer, opts WriteOpts) error {
+	err := f.File.WriteEntry(w, opts)
+	if err != nil {
+		return err
+	}
+
+	opts.Level += 1
+	for _, v := range f.Children {
+		err := v.WriteEntry(w, opts)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CloneWithCtx возвращает копию директории. Клон регистрируется в ctx.Seen
+// до рекурсии в Children, поэтому обратная ссылка на f (или на саму
+// директорию) в дочерних узлах клонируется в уже готовый клон, а не уходит
+// в бесконечную рекурсию.
+func (f *Folder) CloneWithCtx(ctx *CloneContext) Node {
+	if clone, ok := ctx.Seen[f]; ok {
+		return clone
+	}
+
+	clone := &Folder{
+		File:     File{Name: f.Name},
+		Children: make([]Node, len(f.Children)),
+	}
+	ctx.Seen[f] = clone
+
+	for i, v := range f.Children {
+		clone.Children[i] = v.CloneWithCtx(ctx)
+	}
+	return clone
+}
+
+// String сериализует дерево в формате по умолчанию (FormatTree). Чтобы
+// получить JSON или YAML, используйте formatterFor(opts.Format).Format
+// напрямую, например через WriteEntryConcurrent.
+func (f *Folder) String() string {
+	var sb strings.Builder
+	opts := NewWriteOpts()
+	formatterFor(opts.Format).Format(&sb, f, opts)
+	return sb.String()
+}
@@ -0,0 +1,79 @@
+package prototype
+
+import (
+	"encoding/json"
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Format выбирает представление, в котором сериализуется дерево.
+type Format int
+
+const (
+	// FormatTree — отступы по уровню вложенности (формат по умолчанию).
+	FormatTree Format = iota
+	FormatJSON
+	FormatYAML
+)
+
+// treeSnapshot — плоское представление узла, пригодное для JSON/YAML.
+type treeSnapshot struct {
+	Name     string         `json:"name" yaml:"name"`
+	Children []treeSnapshot `json:"children,omitempty" yaml:"children,omitempty"`
+}
+
+// Formatter сериализует узел дерева в w.
+type Formatter interface {
+	Format(w io.Writer, n Node, opts WriteOpts) error
+}
+
+// TreeFormatter выводит дерево построчно с отступом по уровню вложенности,
+// как это всегда делал WriteEntry.
+type TreeFormatter struct{}
+
+func (TreeFormatter) Format(w io.Writer, n Node, opts WriteOpts) error {
+	return n.WriteEntry(w, opts)
+}
+
+// JSONFormatter выводит дерево как отступленный JSON-объект.
+type JSONFormatter struct{}
+
+func (JSONFormatter) Format(w io.Writer, n Node, opts WriteOpts) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(snapshot(n))
+}
+
+// YAMLFormatter выводит дерево как YAML-документ.
+type YAMLFormatter struct{}
+
+func (YAMLFormatter) Format(w io.Writer, n Node, opts WriteOpts) error {
+	return yaml.NewEncoder(w).Encode(snapshot(n))
+}
+
+func snapshot(n Node) treeSnapshot {
+	switch v := n.(type) {
+	case *Folder:
+		children := make([]treeSnapshot, len(v.Children))
+		for i, c := range v.Children {
+			children[i] = snapshot(c)
+		}
+		return treeSnapshot{Name: v.Name, Children: children}
+	case *File:
+		return treeSnapshot{Name: v.Name}
+	default:
+		return treeSnapshot{}
+	}
+}
+
+func formatterFor(f Format) Formatter {
+	switch f {
+	case FormatJSON:
+		return JSONFormatter{}
+	case FormatYAML:
+		return YAMLFormatter{}
+	default:
+		return TreeFormatter{}
+	}
+}
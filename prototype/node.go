@@ -0,0 +1,32 @@
+// Package prototype реализует паттерн Prototype для дерева файлов/директорий:
+// глубокое клонирование через CloneContext и реестр именованных прототипов.
+package prototype
+
+import "io"
+
+// WriteOpts — параметры вывода узла.
+type WriteOpts struct {
+	// Level определяет уровень вложенности файла
+	// для соответствующего сдвига при выводе
+	Level int
+	// Format выбирает формат сериализации (см. TreeFormatter, JSONFormatter, YAMLFormatter).
+	Format Format
+	// Workers — число воркеров, которое использует WriteEntryConcurrent.
+	Workers int
+}
+
+// Node — элемент дерева файлов/директорий, поддерживающий клонирование.
+type Node interface {
+	WriteEntry(w io.Writer, opts WriteOpts) error
+	// CloneWithCtx возвращает клон узла. Реализации обязаны сначала проверить
+	// ctx.Seen и зарегистрировать в нём свой клон, и только потом клонировать
+	// дочерние узлы — это единственный способ корректно завершить обход циклов.
+	CloneWithCtx(ctx *CloneContext) Node
+}
+
+// Clone возвращает глубокую копию n. Общие поддеревья клонируются один раз,
+// а циклы (например, обратная ссылка Folder на предка) не приводят к
+// бесконечной рекурсии.
+func Clone(n Node) Node {
+	return n.CloneWithCtx(NewCloneContext())
+}
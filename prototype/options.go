@@ -0,0 +1,22 @@
+package prototype
+
+import "github.com/Nexadis/creational_patterns/optionbuilder"
+
+// WriteOption настраивает WriteOpts, используемые WriteEntryConcurrent.
+// Walk опций не принимает: он передаёт fn WriteOpts, в которых задан только Level.
+type WriteOption = optionbuilder.Option[WriteOpts]
+
+// WithFormat выбирает формат сериализации.
+func WithFormat(f Format) WriteOption {
+	return optionbuilder.Set(func(o *WriteOpts) *Format { return &o.Format }, f)
+}
+
+// WithWorkers ограничивает число воркеров, которое использует WriteEntryConcurrent.
+func WithWorkers(n int) WriteOption {
+	return optionbuilder.Set(func(o *WriteOpts) *int { return &o.Workers }, n)
+}
+
+// NewWriteOpts строит WriteOpts из functional options.
+func NewWriteOpts(opts ...WriteOption) WriteOpts {
+	return *optionbuilder.Build(opts...)
+}
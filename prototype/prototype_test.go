@@ -0,0 +1,76 @@
+package prototype
+
+import "testing"
+
+func TestCloneFile(t *testing.T) {
+	f := &File{Name: "file1"}
+	clone := Clone(f).(*File)
+
+	if clone == f {
+		t.Fatal("clone must not be the original file")
+	}
+	if clone.Name != f.Name {
+		t.Errorf("wrong clone name: %s", clone.Name)
+	}
+}
+
+func TestCloneFolderSharedChild(t *testing.T) {
+	shared := &File{Name: "shared"}
+	root := &Folder{
+		File:     File{Name: "root"},
+		Children: []Node{shared, shared},
+	}
+
+	clone := Clone(root).(*Folder)
+
+	if clone.Children[0] != clone.Children[1] {
+		t.Error("shared child must be cloned once and referenced from both slots")
+	}
+	if clone.Children[0] == shared {
+		t.Error("cloned child must not be the original node")
+	}
+}
+
+func TestCloneCycle(t *testing.T) {
+	root := &Folder{File: File{Name: "root"}}
+	child := &Folder{File: File{Name: "child"}}
+	root.Children = []Node{child}
+	child.Children = []Node{child, root} // self-reference и обратная ссылка на предка
+
+	clone := Clone(root).(*Folder)
+
+	if clone == root {
+		t.Fatal("clone must not be the original root")
+	}
+
+	clonedChild := clone.Children[0].(*Folder)
+	if clonedChild == child {
+		t.Fatal("clone must not be the original child")
+	}
+	if clonedChild.Children[0] != clonedChild {
+		t.Error("self-reference must point at the clone, not the original")
+	}
+	if clonedChild.Children[1] != clone {
+		t.Error("back-reference to an ancestor must point at the clone, not the original")
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+	r.Register("file", &File{Name: "template"})
+
+	clone, err := r.Get("file")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if clone.(*File).Name != "template" {
+		t.Errorf("wrong clone: %+v", clone)
+	}
+}
+
+func TestRegistryUnknown(t *testing.T) {
+	r := NewRegistry()
+	if _, err := r.Get("missing"); err == nil {
+		t.Fatal("expected error for unregistered prototype")
+	}
+}
@@ -0,0 +1,34 @@
+package prototype
+
+import "sync"
+
+// Registry хранит именованные прототипы и по запросу отдаёт их клоны.
+type Registry struct {
+	mu     sync.RWMutex
+	protos map[string]Node
+}
+
+// NewRegistry создаёт пустой реестр прототипов.
+func NewRegistry() *Registry {
+	return &Registry{protos: make(map[string]Node)}
+}
+
+// Register сохраняет proto под именем name, перезаписывая ранее
+// зарегистрированный под тем же именем.
+func (r *Registry) Register(name string, proto Node) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.protos[name] = proto
+}
+
+// Get возвращает клон прототипа, зарегистрированного под именем name.
+// Возвращает *UnknownPrototypeError, если такого прототипа нет.
+func (r *Registry) Get(name string) (Node, error) {
+	r.mu.RLock()
+	proto, ok := r.protos[name]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, &UnknownPrototypeError{Name: name}
+	}
+	return Clone(proto), nil
+}
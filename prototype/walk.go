@@ -0,0 +1,33 @@
+package prototype
+
+import "context"
+
+// Walk обходит дерево в порядке глубины, вызывая fn для каждого узла вместе
+// с WriteOpts, содержащими его уровень вложенности. Обход прерывается, как
+// только ctx отменяется или fn возвращает ошибку.
+func Walk(ctx context.Context, root Node, fn func(Node, WriteOpts) error) error {
+	return walk(ctx, root, WriteOpts{}, fn)
+}
+
+func walk(ctx context.Context, n Node, opts WriteOpts, fn func(Node, WriteOpts) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := fn(n, opts); err != nil {
+		return err
+	}
+
+	folder, ok := n.(*Folder)
+	if !ok {
+		return nil
+	}
+
+	childOpts := opts
+	childOpts.Level++
+	for _, child := range folder.Children {
+		if err := walk(ctx, child, childOpts, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+// Package singleton реализует ленивый потокобезопасный синглтон поверх
+// дженериков, который, в отличие от sync.Once, не запоминает ошибку
+// инициализации навсегда.
+package singleton
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// InitFunc создаёт значение T. Используется Lazy для отложенной инициализации.
+type InitFunc[T any] func(ctx context.Context) (*T, error)
+
+// Lazy — потокобезопасный ленивый синглтон. Если InitFunc вернула ошибку,
+// значение не запоминается как "инициализация провалена навсегда" (как это
+// делает sync.Once): следующий вызов Get попробует инициализироваться снова.
+type Lazy[T any] struct {
+	init InitFunc[T]
+
+	mu    sync.Mutex
+	value atomic.Pointer[T]
+}
+
+// New создаёт Lazy[T], инициализируемую функцией init при первом Get.
+func New[T any](init InitFunc[T]) *Lazy[T] {
+	return &Lazy[T]{init: init}
+}
+
+// Get возвращает уже инициализированное значение или вызывает init —
+// при первом обращении, либо повторно после того, как предыдущая попытка
+// завершилась ошибкой.
+func (l *Lazy[T]) Get(ctx context.Context) (*T, error) {
+	if v := l.value.Load(); v != nil {
+		return v, nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if v := l.value.Load(); v != nil {
+		return v, nil
+	}
+
+	v, err := l.init(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("singleton: init: %w", err)
+	}
+
+	l.value.Store(v)
+	return v, nil
+}
+
+// MustGet ведёт себя как Get, но паникует, если инициализация завершилась ошибкой.
+func (l *Lazy[T]) MustGet() *T {
+	v, err := l.Get(context.Background())
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,72 @@
+package singleton
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type service struct{}
+
+func TestLazyGetInitializesOnce(t *testing.T) {
+	calls := 0
+	l := New(func(ctx context.Context) (*service, error) {
+		calls++
+		return &service{}, nil
+	})
+
+	first, err := l.Get(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, err := l.Get(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if first != second {
+		t.Error("Get must return the same instance on repeated calls")
+	}
+	if calls != 1 {
+		t.Errorf("init called %d times, want 1", calls)
+	}
+}
+
+func TestLazyGetRetriesAfterError(t *testing.T) {
+	calls := 0
+	l := New(func(ctx context.Context) (*service, error) {
+		calls++
+		if calls == 1 {
+			return nil, errors.New("boom")
+		}
+		return &service{}, nil
+	})
+
+	if _, err := l.Get(context.Background()); err == nil {
+		t.Fatal("expected first Get to fail")
+	}
+
+	v, err := l.Get(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error on retry: %v", err)
+	}
+	if v == nil {
+		t.Fatal("expected a non-nil value after a successful retry")
+	}
+	if calls != 2 {
+		t.Errorf("init called %d times, want 2", calls)
+	}
+}
+
+func TestLazyMustGetPanicsOnError(t *testing.T) {
+	l := New(func(ctx context.Context) (*service, error) {
+		return nil, errors.New("boom")
+	})
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected MustGet to panic")
+		}
+	}()
+	l.MustGet()
+}
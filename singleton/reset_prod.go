@@ -0,0 +1,9 @@
+//go:build !test
+
+package singleton
+
+// Reset в продакшен-сборке ничего не делает: синглтон инициализируется
+// ровно один раз (не считая повторных попыток после ошибки) и не
+// пересоздаётся. Под тегом сборки test доступна реально сбрасывающая версия —
+// см. reset_test_tag.go.
+func (l *Lazy[T]) Reset() {}
@@ -0,0 +1,13 @@
+//go:build test
+
+package singleton
+
+// Reset сбрасывает закэшированное значение, поэтому следующий Get снова
+// вызовет init. Доступно только в сборках с тегом test (go test -tags test),
+// чтобы юнит-тесты могли пересоздавать синглтоны между кейсами — в
+// продакшен-сборке Reset — это no-op, см. reset_prod.go.
+func (l *Lazy[T]) Reset() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.value.Store(nil)
+}
@@ -0,0 +1,41 @@
+//go:build test
+
+package singleton
+
+import (
+	"context"
+	"testing"
+)
+
+type generation struct {
+	n int
+}
+
+func TestLazyResetForcesReinitialization(t *testing.T) {
+	calls := 0
+	l := New(func(ctx context.Context) (*generation, error) {
+		calls++
+		return &generation{n: calls}, nil
+	})
+
+	first, err := l.Get(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if first.n != 1 {
+		t.Fatalf("first.n = %d, want 1", first.n)
+	}
+
+	l.Reset()
+
+	second, err := l.Get(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error after Reset: %v", err)
+	}
+	if second.n != 2 {
+		t.Errorf("Reset did not force reinitialization: second.n = %d, want 2", second.n)
+	}
+	if calls != 2 {
+		t.Errorf("init called %d times, want 2", calls)
+	}
+}